@@ -8,10 +8,10 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/ghodss/yaml"
 	"github.com/sirupsen/logrus"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/yaml"
 
 	cioperatorapi "github.com/openshift/ci-tools/pkg/api"
 	"github.com/openshift/ci-tools/pkg/util"
@@ -104,22 +104,39 @@ type Rehearsals struct {
 	DisabledRehearsals []string `json:"disabled_rehearsals,omitempty"`
 }
 
-func readCiOperatorConfig(configFilePath string, info Info) (*cioperatorapi.ReleaseBuildConfiguration, error) {
-	data, err := gzip.ReadFileMaybeGZIP(configFilePath)
+// ParseReleaseBuildConfiguration reads the ci-operator configuration at the
+// given path without validating it. When strict is set, fields that are not
+// part of the ReleaseBuildConfiguration are reported as errors instead of being
+// silently dropped, which catches typos in the source configuration.
+func ParseReleaseBuildConfiguration(path string, strict bool) (*cioperatorapi.ReleaseBuildConfiguration, error) {
+	data, err := gzip.ReadFileMaybeGZIP(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read ci-operator config (%w)", err)
 	}
 
+	unmarshal := yaml.Unmarshal
+	if strict {
+		unmarshal = yaml.UnmarshalStrict
+	}
 	var configSpec cioperatorapi.ReleaseBuildConfiguration
-	if err := yaml.Unmarshal(data, &configSpec); err != nil {
+	if err := unmarshal(data, &configSpec); err != nil {
 		return nil, fmt.Errorf("failed to load ci-operator config (%w)", err)
 	}
 
-	if err := validation.IsValidConfiguration(&configSpec, info.Org, info.Repo); err != nil {
+	return &configSpec, nil
+}
+
+func readCiOperatorConfig(configFilePath string, info Info) (*cioperatorapi.ReleaseBuildConfiguration, error) {
+	configSpec, err := ParseReleaseBuildConfiguration(configFilePath, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validation.IsValidConfiguration(configSpec, info.Org, info.Repo); err != nil {
 		return nil, fmt.Errorf("invalid ci-operator config: %w", err)
 	}
 
-	return &configSpec, nil
+	return configSpec, nil
 }
 
 // Info describes the metadata for a CI Operator configuration file
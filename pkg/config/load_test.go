@@ -1,9 +1,13 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"k8s.io/apimachinery/pkg/util/diff"
 
 	"github.com/openshift/ci-tools/pkg/api"
@@ -92,3 +96,69 @@ func TestExtractRepoElementsFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestParseReleaseBuildConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		raw           string
+		strict        bool
+		expected      *api.ReleaseBuildConfiguration
+		expectedError bool
+	}{
+		{
+			name: "known fields parse fine",
+			raw: `build_root:
+  project_image:
+    dockerfile_path: Dockerfile
+`,
+			expected: &api.ReleaseBuildConfiguration{
+				InputConfiguration: api.InputConfiguration{
+					BuildRootImage: &api.BuildRootImageConfiguration{
+						ProjectImageBuild: &api.ProjectDirectoryImageBuildInputs{DockerfilePath: "Dockerfile"},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown fields are dropped when not strict",
+			raw: `build_root:
+  project_image:
+    dockerfile_pth: Dockerfile
+`,
+			expected: &api.ReleaseBuildConfiguration{
+				InputConfiguration: api.InputConfiguration{
+					BuildRootImage: &api.BuildRootImageConfiguration{
+						ProjectImageBuild: &api.ProjectDirectoryImageBuildInputs{},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown fields are rejected when strict",
+			raw: `build_root:
+  project_image:
+    dockerfile_pth: Dockerfile
+`,
+			strict:        true,
+			expectedError: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "org-repo-branch.yaml")
+			if err := os.WriteFile(path, []byte(testCase.raw), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+			actual, err := ParseReleaseBuildConfiguration(path, testCase.strict)
+			if err == nil && testCase.expectedError {
+				t.Error("expected an error, but got none")
+			}
+			if err != nil && !testCase.expectedError {
+				t.Errorf("expected no error, but got one: %v", err)
+			}
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected configuration (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}